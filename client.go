@@ -0,0 +1,249 @@
+package tfe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/jsonapi"
+)
+
+const (
+	defaultAddress = "https://app.terraform.io"
+	apiVersionPath = "/api/v2"
+
+	mediaTypeJSONAPI = "application/vnd.api+json"
+)
+
+// Config is used to configure a new TFE Client.
+type Config struct {
+	// Address is the base URL of the TFE instance, e.g.
+	// "https://app.terraform.io". Defaults to the public TFE address.
+	Address string
+
+	// Token is the authentication token used for all API calls.
+	Token string
+
+	// HTTPClient is the HTTP client to use. If nil, a cleanhttp default
+	// client is used.
+	HTTPClient *http.Client
+}
+
+// Client is the client for the Terraform Enterprise API.
+type Client struct {
+	address string
+	token   string
+	http    *http.Client
+}
+
+// NewClient returns a new TFE API client for the given configuration.
+func NewClient(cfg *Config) (*Client, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	address := cfg.Address
+	if address == "" {
+		address = defaultAddress
+	}
+
+	if cfg.Token == "" {
+		return nil, errors.New("Token is required")
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = cleanhttp.DefaultClient()
+	}
+
+	return &Client{
+		address: strings.TrimSuffix(address, "/"),
+		token:   cfg.Token,
+		http:    httpClient,
+	}, nil
+}
+
+// do sends a JSON:API request and, when out is non-nil, unmarshals the
+// response payload into it. body may be nil for requests that carry no
+// payload (GET, DELETE, and the various action endpoints).
+func (c *Client) do(method, path string, query url.Values, body, out interface{}) error {
+	req, err := c.newRequest(method, path, query, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponseCode(resp); err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return jsonapi.UnmarshalPayload(resp.Body, out)
+}
+
+// doMany is identical to do, except that it unmarshals a JSON:API document
+// containing a collection of resources of the given type.
+func (c *Client) doMany(method, path string, query url.Values, kind reflect.Type) ([]interface{}, error) {
+	req, err := c.newRequest(method, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponseCode(resp); err != nil {
+		return nil, err
+	}
+
+	return jsonapi.UnmarshalManyPayload(resp.Body, kind)
+}
+
+// paginatedDocument mirrors the top-level "meta.pagination" block that TFE
+// includes on list endpoints, alongside the JSON:API "data" the jsonapi
+// package already knows how to decode.
+type paginatedDocument struct {
+	Meta struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// doPaginated is identical to doMany, except it also parses the
+// "meta.pagination" block of the response into a Pagination.
+func (c *Client) doPaginated(method, path string, query url.Values, kind reflect.Type) ([]interface{}, *Pagination, error) {
+	req, err := c.newRequest(method, path, query, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponseCode(resp); err != nil {
+		return nil, nil, err
+	}
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(raw), kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc paginatedDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	return items, &doc.Meta.Pagination, nil
+}
+
+func (c *Client) newRequest(method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(c.address + apiVersionPath + path)
+	if err != nil {
+		return nil, err
+	}
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		ensurePrimaryKey(body)
+
+		buf := bytes.NewBuffer(nil)
+		if err := jsonapi.MarshalPayload(buf, body); err != nil {
+			return nil, err
+		}
+		reqBody = buf
+	}
+
+	req, err := http.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", mediaTypeJSONAPI)
+	if body != nil {
+		req.Header.Set("Content-Type", mediaTypeJSONAPI)
+	}
+
+	return req, nil
+}
+
+// ensurePrimaryKey guards against a bug in the hashicorp/jsonapi package,
+// whose MarshalPayload panics if a struct's `jsonapi:"primary,..."` field is
+// a nil pointer. Most of our Create/Modify request bodies leave that field
+// nil, since the ID is server-assigned (or, for actions like lock/apply,
+// doesn't matter at all) - so before marshaling we point it at its type's
+// zero value instead of leaving it nil.
+func ensurePrimaryKey(body interface{}) {
+	v := reflect.ValueOf(body)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("jsonapi")
+		if !strings.HasPrefix(tag, "primary,") {
+			continue
+		}
+
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr && field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return
+	}
+}
+
+// errConflict is returned by checkResponseCode when the API rejects a
+// request with a 409, which TFE uses to report state conflicts such as
+// locking an already-locked workspace. Callers map it to a more specific
+// sentinel error rather than relying on a client-side pre-check, since a
+// pre-check GET can't prevent another client from racing the same change.
+var errConflict = errors.New("conflict")
+
+func checkResponseCode(resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrResourceNotFound
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return errConflict
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testClient returns a Client configured from the TFE_TOKEN/TFE_ADDRESS
+// environment variables, skipping the test if no token is available.
+func testClient(t *testing.T) *Client {
+	token := os.Getenv("TFE_TOKEN")
+	if token == "" {
+		t.Skip("Skipping test: TFE_TOKEN must be set to run e2e tests against a real TFE instance")
+	}
+
+	address := os.Getenv("TFE_ADDRESS")
+
+	client, err := NewClient(&Config{
+		Address: address,
+		Token:   token,
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
+// randomString returns a short random string, suitable for use as part of
+// a resource name in an e2e test.
+func randomString(t *testing.T) string {
+	return fmt.Sprintf("tst-%d", rand.New(rand.NewSource(time.Now().UnixNano())).Int31())
+}
+
+// createOrganization creates a throwaway organization for use by e2e tests
+// and returns a func to clean it up.
+func createOrganization(t *testing.T, client *Client) (*Organization, func()) {
+	output, err := client.CreateOrganization(&CreateOrganizationInput{
+		Name:  String(randomString(t)),
+		Email: String("ci@example.com"),
+	})
+	require.NoError(t, err)
+
+	return output.Organization, func() {
+		_, err := client.DeleteOrganization(&DeleteOrganizationInput{
+			Name: output.Organization.Name,
+		})
+		require.NoError(t, err)
+	}
+}
+
+// createWorkspace creates a throwaway workspace within org for use by e2e
+// tests and returns a func to clean it up.
+func createWorkspace(t *testing.T, client *Client, org *Organization) (*Workspace, func()) {
+	output, err := client.CreateWorkspace(&CreateWorkspaceInput{
+		Organization: org.Name,
+		Name:         String(randomString(t)),
+	})
+	require.NoError(t, err)
+
+	return output.Workspace, func() {
+		_, err := client.DeleteWorkspace(&DeleteWorkspaceInput{
+			Organization: org.Name,
+			Name:         output.Workspace.Name,
+		})
+		require.NoError(t, err)
+	}
+}
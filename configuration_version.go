@@ -0,0 +1,141 @@
+package tfe
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+)
+
+// Configuration version statuses, as returned by the TFE API.
+const (
+	ConfigurationPending  = "pending"
+	ConfigurationUploaded = "uploaded"
+	ConfigurationErrored  = "errored"
+)
+
+// ConfigurationVersion represents an uploaded (or pending) Terraform
+// configuration for a workspace.
+type ConfigurationVersion struct {
+	ID            *string `jsonapi:"primary,configuration-versions"`
+	Status        *string `jsonapi:"attr,status"`
+	UploadURL     *string `jsonapi:"attr,upload-url"`
+	Speculative   *bool   `jsonapi:"attr,speculative,omitempty"`
+	AutoQueueRuns *bool   `jsonapi:"attr,auto-queue-runs,omitempty"`
+}
+
+// CreateConfigurationVersionInput is used as input to
+// Client.CreateConfigurationVersion.
+type CreateConfigurationVersionInput struct {
+	// Speculative configuration versions can be planned but never
+	// applied, and are used to back pull request checks.
+	Speculative *bool
+
+	// AutoQueueRuns controls whether a run is queued automatically once
+	// the configuration finishes uploading. Defaults to true server-side
+	// when left nil.
+	AutoQueueRuns *bool
+}
+
+// CreateConfigurationVersionOutput is returned by
+// Client.CreateConfigurationVersion.
+type CreateConfigurationVersionOutput struct {
+	ConfigurationVersion *ConfigurationVersion
+}
+
+// CreateConfigurationVersion creates a new configuration version for the
+// given workspace. The returned ConfigurationVersion.UploadURL must then be
+// passed to UploadConfiguration (or UploadDirectory/UploadRaw) to upload the
+// actual configuration contents.
+func (c *Client) CreateConfigurationVersion(workspaceID string, input *CreateConfigurationVersionInput) (*CreateConfigurationVersionOutput, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+	if input == nil {
+		input = &CreateConfigurationVersionInput{}
+	}
+
+	body := &ConfigurationVersion{
+		Speculative:   input.Speculative,
+		AutoQueueRuns: input.AutoQueueRuns,
+	}
+
+	cv := &ConfigurationVersion{}
+	path := "/workspaces/" + workspaceID + "/configuration-versions"
+	if err := c.do("POST", path, nil, body, cv); err != nil {
+		return nil, err
+	}
+
+	return &CreateConfigurationVersionOutput{ConfigurationVersion: cv}, nil
+}
+
+// ConfigurationVersion retrieves a configuration version by ID.
+func (c *Client) ConfigurationVersion(id string) (*ConfigurationVersion, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	cv := &ConfigurationVersion{}
+	if err := c.do("GET", "/configuration-versions/"+id, nil, nil, cv); err != nil {
+		return nil, err
+	}
+
+	return cv, nil
+}
+
+// ListConfigurationVersions returns all configuration versions for a
+// workspace, most recent first.
+func (c *Client) ListConfigurationVersions(workspaceID string) ([]*ConfigurationVersion, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+
+	path := "/workspaces/" + workspaceID + "/configuration-versions"
+	raw, err := c.doMany("GET", path, nil, reflect.TypeOf(new(ConfigurationVersion)))
+	if err != nil {
+		return nil, err
+	}
+
+	cvs := make([]*ConfigurationVersion, len(raw))
+	for i, r := range raw {
+		cvs[i] = r.(*ConfigurationVersion)
+	}
+
+	return cvs, nil
+}
+
+// UploadConfiguration PUTs the given content, which must already be a
+// gzipped tarball, to a configuration version's presigned upload URL.
+func (c *Client) UploadConfiguration(uploadURL string, content io.Reader) error {
+	req, err := http.NewRequest("PUT", uploadURL, content)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkResponseCode(resp)
+}
+
+// UploadRaw is an alias for UploadConfiguration, for callers who have
+// already produced a slug archive by some means other than UploadDirectory.
+func (c *Client) UploadRaw(uploadURL string, content io.Reader) error {
+	return c.UploadConfiguration(uploadURL, content)
+}
+
+// UploadDirectory walks the Terraform configuration rooted at path,
+// honoring a .terraformignore file at its root, packs it into a gzipped
+// tarball slug, and uploads it to uploadURL.
+func (c *Client) UploadDirectory(uploadURL, path string) error {
+	r, err := packDirectory(path)
+	if err != nil {
+		return err
+	}
+
+	return c.UploadConfiguration(uploadURL, r)
+}
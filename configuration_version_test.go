@@ -0,0 +1,85 @@
+package tfe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateConfigurationVersion(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("with valid input", func(t *testing.T) {
+		output, err := client.CreateConfigurationVersion(*ws.ID, &CreateConfigurationVersionInput{
+			Speculative:   Bool(true),
+			AutoQueueRuns: Bool(false),
+		})
+		require.Nil(t, err)
+
+		assert.NotNil(t, output.ConfigurationVersion.ID)
+		assert.NotNil(t, output.ConfigurationVersion.UploadURL)
+		assert.Equal(t, ConfigurationPending, *output.ConfigurationVersion.Status)
+	})
+
+	t.Run("when workspace ID is missing", func(t *testing.T) {
+		output, err := client.CreateConfigurationVersion("", nil)
+		assert.EqualError(t, err, "WorkspaceID is required")
+		assert.Nil(t, output)
+	})
+}
+
+func TestConfigurationVersion(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateConfigurationVersion(*ws.ID, nil)
+	require.Nil(t, err)
+
+	t.Run("when the configuration version exists", func(t *testing.T) {
+		result, err := client.ConfigurationVersion(*created.ConfigurationVersion.ID)
+		require.Nil(t, err)
+		assert.Equal(t, created.ConfigurationVersion.ID, result.ID)
+	})
+
+	t.Run("when it does not exist", func(t *testing.T) {
+		result, err := client.ConfigurationVersion("nope")
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestUploadConfiguration(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateConfigurationVersion(*ws.ID, nil)
+	require.Nil(t, err)
+
+	t.Run("UploadRaw", func(t *testing.T) {
+		err := client.UploadRaw(*created.ConfigurationVersion.UploadURL, bytes.NewBufferString("not actually a slug"))
+		require.Nil(t, err)
+	})
+
+	t.Run("UploadDirectory", func(t *testing.T) {
+		err := client.UploadDirectory(*created.ConfigurationVersion.UploadURL, "testdata/configuration-version")
+		require.Nil(t, err)
+	})
+}
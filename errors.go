@@ -0,0 +1,18 @@
+package tfe
+
+import "errors"
+
+var (
+	// ErrResourceNotFound is returned when a resource cannot be located
+	// by the API.
+	ErrResourceNotFound = errors.New("Resource not found")
+
+	// ErrWorkspaceNotLocked is returned when an operation requires a
+	// locked workspace (such as creating a state version) but the
+	// workspace is not currently locked.
+	ErrWorkspaceNotLocked = errors.New("workspace is not locked")
+
+	// ErrWorkspaceLocked is returned when an operation requires an
+	// unlocked workspace but the workspace is already locked.
+	ErrWorkspaceLocked = errors.New("workspace is already locked")
+)
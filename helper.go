@@ -0,0 +1,27 @@
+package tfe
+
+// String returns a pointer to the given string value.
+func String(v string) *string {
+	return &v
+}
+
+// Bool returns a pointer to the given bool value.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to the given int value.
+func Int(v int) *int {
+	return &v
+}
+
+// Int64 returns a pointer to the given int64 value.
+func Int64(v int64) *int64 {
+	return &v
+}
+
+// validString reports whether the given pointer refers to a non-empty
+// string. It is used throughout the input validation helpers.
+func validString(v *string) bool {
+	return v != nil && *v != ""
+}
@@ -0,0 +1,25 @@
+package tfe
+
+import "errors"
+
+// OAuthClient represents a VCS provider connection configured for an
+// organization (GitHub, GitLab, etc.), the parent of its OAuthTokens.
+type OAuthClient struct {
+	ID              *string `jsonapi:"primary,oauth-clients"`
+	Name            *string `jsonapi:"attr,name"`
+	ServiceProvider *string `jsonapi:"attr,service-provider"`
+}
+
+// OAuthClient retrieves an OAuth client by ID.
+func (c *Client) OAuthClient(id string) (*OAuthClient, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	client := &OAuthClient{}
+	if err := c.do("GET", "/oauth-clients/"+id, nil, nil, client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,43 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthTokens(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	t.Run("when the organization has no VCS connections", func(t *testing.T) {
+		tokens, err := client.OAuthTokens(*org.Name)
+		require := assert.New(t)
+		require.Nil(err)
+		require.Empty(tokens)
+	})
+
+	t.Run("when organization is missing", func(t *testing.T) {
+		tokens, err := client.OAuthTokens("")
+		assert.EqualError(t, err, "Organization is required")
+		assert.Nil(t, tokens)
+	})
+}
+
+func TestOAuthClient(t *testing.T) {
+	client := testClient(t)
+
+	t.Run("when it does not exist", func(t *testing.T) {
+		result, err := client.OAuthClient("nope")
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("when ID is missing", func(t *testing.T) {
+		result, err := client.OAuthClient("")
+		assert.EqualError(t, err, "ID is required")
+		assert.Nil(t, result)
+	})
+}
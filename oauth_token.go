@@ -0,0 +1,33 @@
+package tfe
+
+import (
+	"errors"
+	"reflect"
+)
+
+// OAuthToken represents a VCS OAuth token belonging to an organization,
+// usable as a workspace's VCSRepoOptions.OAuthTokenID.
+type OAuthToken struct {
+	ID                  *string `jsonapi:"primary,oauth-tokens"`
+	ServiceProviderUser *string `jsonapi:"attr,service-provider-user"`
+}
+
+// OAuthTokens lists the OAuth tokens available to an organization.
+func (c *Client) OAuthTokens(orgName string) ([]*OAuthToken, error) {
+	if orgName == "" {
+		return nil, errors.New("Organization is required")
+	}
+
+	path := "/organizations/" + orgName + "/oauth-tokens"
+	raw, err := c.doMany("GET", path, nil, reflect.TypeOf(new(OAuthToken)))
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*OAuthToken, len(raw))
+	for i, r := range raw {
+		tokens[i] = r.(*OAuthToken)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,79 @@
+package tfe
+
+import "errors"
+
+// Organization represents a TFE organization.
+type Organization struct {
+	Name  *string `jsonapi:"primary,organizations"`
+	Email *string `jsonapi:"attr,email"`
+}
+
+// CreateOrganizationInput is used as input to Client.CreateOrganization.
+type CreateOrganizationInput struct {
+	Name  *string `jsonapi:"primary,organizations"`
+	Email *string `jsonapi:"attr,email"`
+}
+
+func (i *CreateOrganizationInput) valid() error {
+	if !validString(i.Name) {
+		return errors.New("Name is required")
+	}
+	if !validString(i.Email) {
+		return errors.New("Email is required")
+	}
+	return nil
+}
+
+// CreateOrganizationOutput is returned by Client.CreateOrganization.
+type CreateOrganizationOutput struct {
+	Organization *Organization
+}
+
+// CreateOrganization creates a new organization with the given input.
+func (c *Client) CreateOrganization(input *CreateOrganizationInput) (*CreateOrganizationOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	org := &Organization{}
+	if err := c.do("POST", "/organizations", nil, input, org); err != nil {
+		return nil, err
+	}
+
+	return &CreateOrganizationOutput{Organization: org}, nil
+}
+
+// Organization retrieves an organization by name.
+func (c *Client) Organization(name string) (*Organization, error) {
+	if name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	org := &Organization{}
+	if err := c.do("GET", "/organizations/"+name, nil, nil, org); err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// DeleteOrganizationInput is used as input to Client.DeleteOrganization.
+type DeleteOrganizationInput struct {
+	Name *string
+}
+
+// DeleteOrganizationOutput is returned by Client.DeleteOrganization.
+type DeleteOrganizationOutput struct{}
+
+// DeleteOrganization deletes the organization with the given name.
+func (c *Client) DeleteOrganization(input *DeleteOrganizationInput) (*DeleteOrganizationOutput, error) {
+	if !validString(input.Name) {
+		return nil, errors.New("Name is required")
+	}
+
+	if err := c.do("DELETE", "/organizations/"+*input.Name, nil, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return &DeleteOrganizationOutput{}, nil
+}
@@ -0,0 +1,11 @@
+package tfe
+
+// Pagination describes the "meta.pagination" block TFE attaches to list
+// endpoint responses.
+type Pagination struct {
+	CurrentPage  int `json:"current-page"`
+	NextPage     int `json:"next-page"`
+	PreviousPage int `json:"previous-page"`
+	TotalPages   int `json:"total-pages"`
+	TotalCount   int `json:"total-count"`
+}
@@ -0,0 +1,262 @@
+package tfe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Run statuses, as returned by the TFE API.
+const (
+	RunPending            = "pending"
+	RunPlanning           = "planning"
+	RunPlanned            = "planned"
+	RunPlannedAndFinished = "planned_and_finished"
+	RunApplying           = "applying"
+	RunApplied            = "applied"
+	RunErrored            = "errored"
+	RunDiscarded          = "discarded"
+	RunCanceled           = "canceled"
+)
+
+// runPollInterval is how often CreateRun polls a run's status while
+// waiting to auto-apply it.
+var runPollInterval = 2 * time.Second
+
+// runPollTimeout bounds how long CreateRun will wait for a plan to reach a
+// terminal status before giving up, so a stuck run can't hang the caller
+// forever.
+var runPollTimeout = 30 * time.Minute
+
+// Run represents a Terraform run against a workspace.
+type Run struct {
+	ID        *string `jsonapi:"primary,runs"`
+	Status    *string `jsonapi:"attr,status"`
+	Message   *string `jsonapi:"attr,message"`
+	IsDestroy *bool   `jsonapi:"attr,is-destroy"`
+
+	Workspace            *Workspace            `jsonapi:"relation,workspace"`
+	ConfigurationVersion *ConfigurationVersion `jsonapi:"relation,configuration-version"`
+	Plan                 *Plan                 `jsonapi:"relation,plan"`
+	Apply                *Apply                `jsonapi:"relation,apply"`
+}
+
+// Plan represents the plan phase of a run.
+type Plan struct {
+	ID         *string `jsonapi:"primary,plans"`
+	Status     *string `jsonapi:"attr,status"`
+	HasChanges *bool   `jsonapi:"attr,has-changes"`
+	LogReadURL *string `jsonapi:"attr,log-read-url"`
+}
+
+// Apply represents the apply phase of a run.
+type Apply struct {
+	ID         *string `jsonapi:"primary,applies"`
+	Status     *string `jsonapi:"attr,status"`
+	LogReadURL *string `jsonapi:"attr,log-read-url"`
+}
+
+// CreateRunInput is used as input to Client.CreateRun.
+type CreateRunInput struct {
+	WorkspaceID *string
+
+	// ConfigurationVersionID is optional. When omitted, the workspace's
+	// most recent configuration version is used.
+	ConfigurationVersionID *string
+
+	Message   *string
+	IsDestroy *bool
+
+	// AutoApply, when true, causes CreateRun to poll the run until its
+	// plan has finished and then automatically apply it, mirroring the
+	// auto-approve behavior of the enhanced remote backend. A plan with
+	// no changes is left in the "planned_and_finished" status rather
+	// than applied.
+	AutoApply *bool
+}
+
+func (i *CreateRunInput) valid() error {
+	if !validString(i.WorkspaceID) {
+		return errors.New("WorkspaceID is required")
+	}
+	return nil
+}
+
+// CreateRunOutput is returned by Client.CreateRun.
+type CreateRunOutput struct {
+	Run *Run
+}
+
+// CreateRun creates and queues a new run for a workspace. If input.AutoApply
+// is set, CreateRun blocks until the plan reaches the "planned" status (or
+// "planned_and_finished") and, in the former case, applies it automatically.
+func (c *Client) CreateRun(input *CreateRunInput) (*CreateRunOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &Run{
+		Message:   input.Message,
+		IsDestroy: input.IsDestroy,
+		Workspace: &Workspace{ID: input.WorkspaceID},
+	}
+	if input.ConfigurationVersionID != nil {
+		body.ConfigurationVersion = &ConfigurationVersion{ID: input.ConfigurationVersionID}
+	}
+
+	run := &Run{}
+	if err := c.do("POST", "/runs", nil, body, run); err != nil {
+		return nil, err
+	}
+
+	if input.AutoApply != nil && *input.AutoApply {
+		var err error
+		run, err = c.waitForPlan(*run.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if *run.Status == RunPlanned {
+			if _, err := c.ApplyRun(*run.ID, "auto-applied by go-tfe"); err != nil {
+				return nil, err
+			}
+
+			run, err = c.Run(*run.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &CreateRunOutput{Run: run}, nil
+}
+
+// waitForPlan polls a run until its plan reaches a terminal status, giving
+// up with an error after runPollTimeout.
+func (c *Client) waitForPlan(id string) (*Run, error) {
+	deadline := time.Now().Add(runPollTimeout)
+
+	for {
+		run, err := c.Run(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch *run.Status {
+		case RunPlanned, RunPlannedAndFinished, RunErrored, RunDiscarded, RunCanceled:
+			return run, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for run %s to finish planning", runPollTimeout, id)
+		}
+
+		time.Sleep(runPollInterval)
+	}
+}
+
+// Run retrieves a run by ID.
+func (c *Client) Run(id string) (*Run, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	run := &Run{}
+	if err := c.do("GET", "/runs/"+id, nil, nil, run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// runAction is the payload sent to the run action endpoints, which all
+// accept an optional operator comment.
+type runAction struct {
+	ID      *string `jsonapi:"primary,runs"`
+	Comment *string `jsonapi:"attr,comment"`
+}
+
+// ApplyRun applies a run whose plan has finished. comment is recorded as
+// the operator's reason for approving the apply.
+func (c *Client) ApplyRun(id, comment string) (*Run, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	if err := c.do("POST", "/runs/"+id+"/actions/apply", nil, &runAction{Comment: String(comment)}, nil); err != nil {
+		return nil, err
+	}
+
+	return c.Run(id)
+}
+
+// DiscardRun discards a run, skipping its apply. comment is recorded as the
+// operator's reason for discarding it.
+func (c *Client) DiscardRun(id, comment string) (*Run, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	if err := c.do("POST", "/runs/"+id+"/actions/discard", nil, &runAction{Comment: String(comment)}, nil); err != nil {
+		return nil, err
+	}
+
+	return c.Run(id)
+}
+
+// CancelRun cancels a run that is currently planning or applying.
+func (c *Client) CancelRun(id string) (*Run, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	if err := c.do("POST", "/runs/"+id+"/actions/cancel", nil, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return c.Run(id)
+}
+
+// RunLogs streams the plan or apply log for the given plan or apply ID. It
+// looks up the resource to resolve its log-read-url and then streams that
+// URL's contents, since TFE only returns that URL at read time.
+func (c *Client) RunLogs(id string) (io.ReadCloser, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	plan := &Plan{}
+	if err := c.do("GET", "/plans/"+id, nil, nil, plan); err == nil {
+		if plan.LogReadURL == nil {
+			return nil, errors.New("log not yet available")
+		}
+		return c.streamLogs(*plan.LogReadURL)
+	}
+
+	apply := &Apply{}
+	if err := c.do("GET", "/applies/"+id, nil, nil, apply); err != nil {
+		return nil, err
+	}
+	if apply.LogReadURL == nil {
+		return nil, errors.New("log not yet available")
+	}
+
+	return c.streamLogs(*apply.LogReadURL)
+}
+
+// streamLogs issues an unauthenticated GET against a presigned log URL and
+// returns the response body for the caller to read and close.
+func (c *Client) streamLogs(logURL string) (io.ReadCloser, error) {
+	resp, err := c.http.Get(logURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponseCode(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,116 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRun(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("with valid input", func(t *testing.T) {
+		output, err := client.CreateRun(&CreateRunInput{
+			WorkspaceID: ws.ID,
+			Message:     String("from go-tfe tests"),
+		})
+		require.Nil(t, err)
+
+		assert.NotNil(t, output.Run.ID)
+		assert.Equal(t, RunPending, *output.Run.Status)
+	})
+
+	t.Run("when input is missing workspace ID", func(t *testing.T) {
+		output, err := client.CreateRun(&CreateRunInput{
+			Message: String("from go-tfe tests"),
+		})
+		assert.EqualError(t, err, "WorkspaceID is required")
+		assert.Nil(t, output)
+	})
+
+	t.Run("with auto apply", func(t *testing.T) {
+		output, err := client.CreateRun(&CreateRunInput{
+			WorkspaceID: ws.ID,
+			AutoApply:   Bool(true),
+		})
+		require.Nil(t, err)
+
+		switch *output.Run.Status {
+		case RunApplied, RunApplying, RunPlannedAndFinished:
+		default:
+			t.Fatalf("unexpected status after auto apply: %s", *output.Run.Status)
+		}
+	})
+}
+
+func TestRun(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateRun(&CreateRunInput{WorkspaceID: ws.ID})
+	require.Nil(t, err)
+
+	t.Run("when the run exists", func(t *testing.T) {
+		result, err := client.Run(*created.Run.ID)
+		require.Nil(t, err)
+		assert.Equal(t, created.Run.ID, result.ID)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		result, err := client.Run("nope")
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("when ID is missing", func(t *testing.T) {
+		result, err := client.Run("")
+		assert.EqualError(t, err, "ID is required")
+		assert.Nil(t, result)
+	})
+}
+
+func TestDiscardRun(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateRun(&CreateRunInput{WorkspaceID: ws.ID})
+	require.Nil(t, err)
+
+	result, err := client.DiscardRun(*created.Run.ID, "not needed")
+	require.Nil(t, err)
+	assert.Equal(t, RunDiscarded, *result.Status)
+}
+
+func TestCancelRun(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateRun(&CreateRunInput{WorkspaceID: ws.ID})
+	require.Nil(t, err)
+
+	result, err := client.CancelRun(*created.Run.ID)
+	require.Nil(t, err)
+	assert.Equal(t, RunCanceled, *result.Status)
+}
@@ -0,0 +1,22 @@
+package tfe
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/hashicorp/go-slug"
+)
+
+// packDirectory packs the Terraform configuration rooted at dir into an
+// in-memory gzipped tarball slug, honoring any .terraformignore file found
+// at the root, the same way the enhanced remote backend packages modules
+// for upload.
+func packDirectory(dir string) (io.Reader, error) {
+	buf := &bytes.Buffer{}
+
+	if _, err := slug.Pack(dir, buf, true); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -0,0 +1,174 @@
+package tfe
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"reflect"
+)
+
+// StateVersion represents a snapshot of a workspace's Terraform state at a
+// given serial.
+type StateVersion struct {
+	ID          *string `jsonapi:"primary,state-versions"`
+	Serial      *int64  `jsonapi:"attr,serial"`
+	MD5         *string `jsonapi:"attr,md5"`
+	Lineage     *string `jsonapi:"attr,lineage"`
+	DownloadURL *string `jsonapi:"attr,hosted-state-download-url"`
+}
+
+// createStateVersionBody is the payload sent to the create endpoint. It
+// carries the base64-encoded state in addition to the fields that
+// StateVersion exposes on read, which TFE never echoes back.
+type createStateVersionBody struct {
+	ID      *string `jsonapi:"primary,state-versions"`
+	Serial  *int64  `jsonapi:"attr,serial"`
+	MD5     *string `jsonapi:"attr,md5"`
+	Lineage *string `jsonapi:"attr,lineage"`
+	State   *string `jsonapi:"attr,state"`
+}
+
+// CreateStateVersionInput is used as input to Client.CreateStateVersion.
+type CreateStateVersionInput struct {
+	Serial *int64
+
+	// MD5 is the hex-encoded MD5 checksum of State. If omitted, it is
+	// computed automatically.
+	MD5 *string
+
+	Lineage *string
+
+	// State is the raw (not base64-encoded) Terraform state file
+	// contents.
+	State []byte
+}
+
+func (i *CreateStateVersionInput) valid() error {
+	if i.Serial == nil {
+		return errors.New("Serial is required")
+	}
+	if len(i.State) == 0 {
+		return errors.New("State is required")
+	}
+	return nil
+}
+
+// CreateStateVersionOutput is returned by Client.CreateStateVersion.
+type CreateStateVersionOutput struct {
+	StateVersion *StateVersion
+}
+
+// CreateStateVersion creates a new state version for a workspace. TFE
+// requires the workspace to be locked before a state version can be
+// created; if it is not, ErrWorkspaceNotLocked is returned.
+func (c *Client) CreateStateVersion(workspaceID string, input *CreateStateVersionInput) (*CreateStateVersionOutput, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	md5sum := input.MD5
+	if md5sum == nil {
+		sum := md5.Sum(input.State)
+		md5sum = String(hex.EncodeToString(sum[:]))
+	}
+
+	body := &createStateVersionBody{
+		Serial:  input.Serial,
+		MD5:     md5sum,
+		Lineage: input.Lineage,
+		State:   String(base64.StdEncoding.EncodeToString(input.State)),
+	}
+
+	sv := &StateVersion{}
+	path := "/workspaces/" + workspaceID + "/state-versions"
+	if err := c.do("POST", path, nil, body, sv); err != nil {
+		if err == errConflict {
+			return nil, ErrWorkspaceNotLocked
+		}
+		return nil, err
+	}
+
+	return &CreateStateVersionOutput{StateVersion: sv}, nil
+}
+
+// CurrentStateVersion returns the most recent state version for a
+// workspace.
+func (c *Client) CurrentStateVersion(workspaceID string) (*StateVersion, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+
+	sv := &StateVersion{}
+	path := "/workspaces/" + workspaceID + "/current-state-version"
+	if err := c.do("GET", path, nil, nil, sv); err != nil {
+		return nil, err
+	}
+
+	return sv, nil
+}
+
+// ListStateVersions returns all state versions for the given workspace,
+// most recent first.
+func (c *Client) ListStateVersions(orgName, workspaceName string) ([]*StateVersion, error) {
+	if orgName == "" {
+		return nil, errors.New("Organization is required")
+	}
+	if workspaceName == "" {
+		return nil, errors.New("WorkspaceName is required")
+	}
+
+	q := url.Values{}
+	q.Set("filter[workspace][name]", workspaceName)
+	q.Set("filter[organization][name]", orgName)
+
+	raw, err := c.doMany("GET", "/state-versions", q, reflect.TypeOf(new(StateVersion)))
+	if err != nil {
+		return nil, err
+	}
+
+	svs := make([]*StateVersion, len(raw))
+	for i, r := range raw {
+		svs[i] = r.(*StateVersion)
+	}
+
+	return svs, nil
+}
+
+// StateVersion retrieves a state version by ID.
+func (c *Client) StateVersion(id string) (*StateVersion, error) {
+	if id == "" {
+		return nil, errors.New("ID is required")
+	}
+
+	sv := &StateVersion{}
+	if err := c.do("GET", "/state-versions/"+id, nil, nil, sv); err != nil {
+		return nil, err
+	}
+
+	return sv, nil
+}
+
+// DownloadState downloads and returns the raw Terraform state for sv.
+func (c *Client) DownloadState(sv *StateVersion) ([]byte, error) {
+	if sv == nil || sv.DownloadURL == nil {
+		return nil, errors.New("StateVersion has no download URL")
+	}
+
+	resp, err := c.http.Get(*sv.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponseCode(resp); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
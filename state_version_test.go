@@ -0,0 +1,112 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStateVersion(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("when the workspace is not locked", func(t *testing.T) {
+		output, err := client.CreateStateVersion(*ws.ID, &CreateStateVersionInput{
+			Serial: Int64(1),
+			State:  []byte(`{"version":4}`),
+		})
+		assert.Equal(t, ErrWorkspaceNotLocked, err)
+		assert.Nil(t, output)
+	})
+
+	t.Run("with valid input", func(t *testing.T) {
+		_, err := client.LockWorkspace(*ws.ID, &LockWorkspaceInput{Reason: String("testing")})
+		require.Nil(t, err)
+		defer client.UnlockWorkspace(*ws.ID)
+
+		state := []byte(`{"version":4,"serial":1}`)
+		output, err := client.CreateStateVersion(*ws.ID, &CreateStateVersionInput{
+			Serial:  Int64(1),
+			Lineage: String("test-lineage"),
+			State:   state,
+		})
+		require.Nil(t, err)
+
+		sv := output.StateVersion
+		assert.NotNil(t, sv.ID)
+		assert.Equal(t, Int64(1), sv.Serial)
+		assert.Equal(t, String("test-lineage"), sv.Lineage)
+
+		current, err := client.CurrentStateVersion(*ws.ID)
+		require.Nil(t, err)
+		assert.Equal(t, sv.ID, current.ID)
+		assert.Equal(t, sv.Serial, current.Serial)
+
+		downloaded, err := client.DownloadState(current)
+		require.Nil(t, err)
+		assert.Equal(t, state, downloaded)
+	})
+
+	t.Run("when input is missing state", func(t *testing.T) {
+		output, err := client.CreateStateVersion(*ws.ID, &CreateStateVersionInput{
+			Serial: Int64(1),
+		})
+		assert.EqualError(t, err, "State is required")
+		assert.Nil(t, output)
+	})
+
+	t.Run("when workspace ID is missing", func(t *testing.T) {
+		output, err := client.CreateStateVersion("", &CreateStateVersionInput{
+			Serial: Int64(1),
+			State:  []byte(`{"version":4}`),
+		})
+		assert.EqualError(t, err, "WorkspaceID is required")
+		assert.Nil(t, output)
+	})
+}
+
+func TestStateVersion(t *testing.T) {
+	client := testClient(t)
+
+	t.Run("when it does not exist", func(t *testing.T) {
+		result, err := client.StateVersion("nope")
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("when ID is missing", func(t *testing.T) {
+		result, err := client.StateVersion("")
+		assert.EqualError(t, err, "ID is required")
+		assert.Nil(t, result)
+	})
+}
+
+func TestListStateVersions(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	result, err := client.ListStateVersions(*org.Name, *ws.Name)
+	assert.Nil(t, err)
+	assert.Empty(t, result)
+}
+
+func TestDownloadState(t *testing.T) {
+	client := testClient(t)
+
+	t.Run("when the state version has no download URL", func(t *testing.T) {
+		result, err := client.DownloadState(&StateVersion{})
+		assert.EqualError(t, err, "StateVersion has no download URL")
+		assert.Nil(t, result)
+	})
+}
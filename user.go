@@ -0,0 +1,7 @@
+package tfe
+
+// User represents a TFE user account.
+type User struct {
+	ID       *string `jsonapi:"primary,users"`
+	Username *string `jsonapi:"attr,username"`
+}
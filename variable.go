@@ -0,0 +1,164 @@
+package tfe
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Variable categories, as accepted by the TFE API.
+const (
+	CategoryTerraform = "terraform"
+	CategoryEnv       = "env"
+)
+
+// Variable represents a Terraform input variable or environment variable
+// attached to a workspace. TFE never returns Value for variables marked
+// Sensitive.
+type Variable struct {
+	ID        *string `jsonapi:"primary,vars"`
+	Key       *string `jsonapi:"attr,key,omitempty"`
+	Value     *string `jsonapi:"attr,value,omitempty"`
+	Category  *string `jsonapi:"attr,category,omitempty"`
+	HCL       *bool   `jsonapi:"attr,hcl,omitempty"`
+	Sensitive *bool   `jsonapi:"attr,sensitive,omitempty"`
+}
+
+// Variables lists the variables attached to a workspace.
+func (c *Client) Variables(workspaceID string) ([]*Variable, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+
+	path := "/workspaces/" + workspaceID + "/vars"
+	raw, err := c.doMany("GET", path, nil, reflect.TypeOf(new(Variable)))
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make([]*Variable, len(raw))
+	for i, r := range raw {
+		vars[i] = r.(*Variable)
+	}
+
+	return vars, nil
+}
+
+// CreateVariableInput is used as input to Client.CreateVariable.
+type CreateVariableInput struct {
+	WorkspaceID *string
+	Key         *string
+	Value       *string
+	Category    *string
+	HCL         *bool
+	Sensitive   *bool
+}
+
+func (i *CreateVariableInput) valid() error {
+	if !validString(i.WorkspaceID) {
+		return errors.New("WorkspaceID is required")
+	}
+	if !validString(i.Key) {
+		return errors.New("Key is required")
+	}
+	if !validString(i.Category) {
+		return errors.New("Category is required")
+	}
+	return nil
+}
+
+// CreateVariableOutput is returned by Client.CreateVariable.
+type CreateVariableOutput struct {
+	Variable *Variable
+}
+
+// CreateVariable creates a new variable on a workspace.
+func (c *Client) CreateVariable(input *CreateVariableInput) (*CreateVariableOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &Variable{
+		Key:       input.Key,
+		Value:     input.Value,
+		Category:  input.Category,
+		HCL:       input.HCL,
+		Sensitive: input.Sensitive,
+	}
+
+	v := &Variable{}
+	path := "/workspaces/" + *input.WorkspaceID + "/vars"
+	if err := c.do("POST", path, nil, body, v); err != nil {
+		return nil, err
+	}
+
+	return &CreateVariableOutput{Variable: v}, nil
+}
+
+// ModifyVariableInput is used as input to Client.ModifyVariable. Only the
+// fields the caller sets are sent in the PATCH request, so that an unset
+// Value on a Sensitive variable does not clobber it.
+type ModifyVariableInput struct {
+	VariableID *string
+
+	Key       *string
+	Value     *string
+	HCL       *bool
+	Sensitive *bool
+}
+
+func (i *ModifyVariableInput) valid() error {
+	if !validString(i.VariableID) {
+		return errors.New("VariableID is required")
+	}
+	return nil
+}
+
+// ModifyVariableOutput is returned by Client.ModifyVariable.
+type ModifyVariableOutput struct {
+	Variable *Variable
+}
+
+// ModifyVariable updates an existing variable, changing only the fields
+// set on input.
+func (c *Client) ModifyVariable(input *ModifyVariableInput) (*ModifyVariableOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &Variable{
+		Key:       input.Key,
+		Value:     input.Value,
+		HCL:       input.HCL,
+		Sensitive: input.Sensitive,
+	}
+
+	v := &Variable{}
+	path := "/vars/" + *input.VariableID
+	if err := c.do("PATCH", path, nil, body, v); err != nil {
+		return nil, err
+	}
+
+	return &ModifyVariableOutput{Variable: v}, nil
+}
+
+// DeleteVariableInput is used as input to Client.DeleteVariable.
+type DeleteVariableInput struct {
+	VariableID *string
+}
+
+// DeleteVariableOutput is returned by Client.DeleteVariable.
+type DeleteVariableOutput struct{}
+
+// DeleteVariable deletes a variable from a workspace.
+func (c *Client) DeleteVariable(input *DeleteVariableInput) (*DeleteVariableOutput, error) {
+	if !validString(input.VariableID) {
+		return nil, errors.New("VariableID is required")
+	}
+
+	path := "/vars/" + *input.VariableID
+	if err := c.do("DELETE", path, nil, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return &DeleteVariableOutput{}, nil
+}
@@ -0,0 +1,159 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariables(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	output, err := client.CreateVariable(&CreateVariableInput{
+		WorkspaceID: ws.ID,
+		Key:         String("foo"),
+		Value:       String("bar"),
+		Category:    String(CategoryEnv),
+	})
+	require.Nil(t, err)
+
+	vars, err := client.Variables(*ws.ID)
+	require.Nil(t, err)
+	assert.Equal(t, []*Variable{output.Variable}, vars)
+}
+
+func TestCreateVariable(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("with valid input", func(t *testing.T) {
+		input := &CreateVariableInput{
+			WorkspaceID: ws.ID,
+			Key:         String("image_id"),
+			Value:       String("ami-latest"),
+			Category:    String(CategoryTerraform),
+			HCL:         Bool(false),
+		}
+
+		output, err := client.CreateVariable(input)
+		require.Nil(t, err)
+
+		assert.NotNil(t, output.Variable.ID)
+		assert.Equal(t, input.Key, output.Variable.Key)
+		assert.Equal(t, input.Value, output.Variable.Value)
+		assert.Equal(t, input.Category, output.Variable.Category)
+	})
+
+	t.Run("when sensitive, the value is never echoed back", func(t *testing.T) {
+		output, err := client.CreateVariable(&CreateVariableInput{
+			WorkspaceID: ws.ID,
+			Key:         String("api_token"),
+			Value:       String("super-secret"),
+			Category:    String(CategoryEnv),
+			Sensitive:   Bool(true),
+		})
+		require.Nil(t, err)
+
+		assert.Nil(t, output.Variable.Value)
+	})
+
+	t.Run("when input is missing workspace ID", func(t *testing.T) {
+		result, err := client.CreateVariable(&CreateVariableInput{
+			Key:      String("foo"),
+			Category: String(CategoryEnv),
+		})
+		assert.EqualError(t, err, "WorkspaceID is required")
+		assert.Nil(t, result)
+	})
+
+	t.Run("when input is missing key", func(t *testing.T) {
+		result, err := client.CreateVariable(&CreateVariableInput{
+			WorkspaceID: ws.ID,
+			Category:    String(CategoryEnv),
+		})
+		assert.EqualError(t, err, "Key is required")
+		assert.Nil(t, result)
+	})
+
+	t.Run("when input is missing category", func(t *testing.T) {
+		result, err := client.CreateVariable(&CreateVariableInput{
+			WorkspaceID: ws.ID,
+			Key:         String("foo"),
+		})
+		assert.EqualError(t, err, "Category is required")
+		assert.Nil(t, result)
+	})
+}
+
+func TestModifyVariable(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateVariable(&CreateVariableInput{
+		WorkspaceID: ws.ID,
+		Key:         String("foo"),
+		Value:       String("bar"),
+		Category:    String(CategoryEnv),
+	})
+	require.Nil(t, err)
+
+	t.Run("when updating a subset of values", func(t *testing.T) {
+		output, err := client.ModifyVariable(&ModifyVariableInput{
+			VariableID: created.Variable.ID,
+			Value:      String("baz"),
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, created.Variable.Key, output.Variable.Key)
+		assert.Equal(t, String("baz"), output.Variable.Value)
+	})
+
+	t.Run("when input is missing variable ID", func(t *testing.T) {
+		result, err := client.ModifyVariable(&ModifyVariableInput{
+			Value: String("baz"),
+		})
+		assert.EqualError(t, err, "VariableID is required")
+		assert.Nil(t, result)
+	})
+}
+
+func TestDeleteVariable(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	created, err := client.CreateVariable(&CreateVariableInput{
+		WorkspaceID: ws.ID,
+		Key:         String("foo"),
+		Value:       String("bar"),
+		Category:    String(CategoryEnv),
+	})
+	require.Nil(t, err)
+
+	output, err := client.DeleteVariable(&DeleteVariableInput{
+		VariableID: created.Variable.ID,
+	})
+	require.Nil(t, err)
+	require.Equal(t, &DeleteVariableOutput{}, output)
+}
@@ -0,0 +1,342 @@
+package tfe
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+)
+
+// Workspace represents a TFE workspace.
+//
+// The optional attrs are all tagged omitempty: Workspace doubles as the
+// request body for CreateWorkspace/ModifyWorkspace, and an unset pointer
+// must be left out of the payload rather than marshaled as a JSON null,
+// or a partial ModifyWorkspace call would reset every field the caller
+// didn't touch.
+type Workspace struct {
+	ID               *string      `jsonapi:"primary,workspaces"`
+	Name             *string      `jsonapi:"attr,name,omitempty"`
+	AutoApply        *bool        `jsonapi:"attr,auto-apply,omitempty"`
+	TerraformVersion *string      `jsonapi:"attr,terraform-version,omitempty"`
+	WorkingDirectory *string      `jsonapi:"attr,working-directory,omitempty"`
+	Locked           *bool        `jsonapi:"attr,locked,omitempty"`
+	Permissions      *Permissions `jsonapi:"attr,permissions,omitempty"`
+	LockedBy         *User        `jsonapi:"relation,locked-by"`
+
+	VCSRepo             *VCSRepo `jsonapi:"attr,vcs-repo,omitempty"`
+	FileTriggersEnabled *bool    `jsonapi:"attr,file-triggers-enabled,omitempty"`
+	TriggerPrefixes     []string `jsonapi:"attr,trigger-prefixes,omitempty"`
+}
+
+// VCSRepo describes the VCS repository a workspace is linked to, as
+// reported by the TFE API.
+type VCSRepo struct {
+	Identifier        *string `json:"identifier"`
+	Branch            *string `json:"branch"`
+	IngressSubmodules *bool   `json:"ingress-submodules"`
+	OAuthTokenID      *string `json:"oauth-token-id"`
+}
+
+// MarshalJSON marshals a zero-value VCSRepo (all fields nil) as a literal
+// JSON null rather than an object of null fields, so that passing
+// &VCSRepoOptions{} to CreateWorkspace/ModifyWorkspace actually clears the
+// workspace's VCS connection instead of merely nulling out each of its
+// sub-fields.
+func (v *VCSRepo) MarshalJSON() ([]byte, error) {
+	if v.Identifier == nil && v.Branch == nil && v.IngressSubmodules == nil && v.OAuthTokenID == nil {
+		return []byte("null"), nil
+	}
+
+	type alias VCSRepo
+	return json.Marshal((*alias)(v))
+}
+
+// VCSRepoOptions describes the VCS repository to link (or unlink) a
+// workspace to, as supplied by the caller on create/modify. Passing a
+// non-nil, zero-value *VCSRepoOptions clears an existing VCS connection.
+type VCSRepoOptions struct {
+	Identifier        *string
+	Branch            *string
+	IngressSubmodules *bool
+	OAuthTokenID      *string
+}
+
+func (o *VCSRepoOptions) toVCSRepo() *VCSRepo {
+	if o == nil {
+		return nil
+	}
+
+	return &VCSRepo{
+		Identifier:        o.Identifier,
+		Branch:            o.Branch,
+		IngressSubmodules: o.IngressSubmodules,
+		OAuthTokenID:      o.OAuthTokenID,
+	}
+}
+
+// Permissions describes the actions the current user may take against a
+// resource.
+type Permissions struct {
+	CanDestroy bool `json:"can-destroy"`
+	CanLock    bool `json:"can-lock"`
+	CanUnlock  bool `json:"can-unlock"`
+	CanUpdate  bool `json:"can-update"`
+}
+
+// Can reports whether the permission set grants the given action.
+func (p *Permissions) Can(action string) bool {
+	switch action {
+	case "destroy":
+		return p.CanDestroy
+	case "lock":
+		return p.CanLock
+	case "unlock":
+		return p.CanUnlock
+	case "update":
+		return p.CanUpdate
+	default:
+		return false
+	}
+}
+
+// WorkspaceNameSort implements sort.Interface to order workspaces by name.
+type WorkspaceNameSort []*Workspace
+
+func (s WorkspaceNameSort) Len() int      { return len(s) }
+func (s WorkspaceNameSort) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s WorkspaceNameSort) Less(i, j int) bool {
+	return *s[i].Name < *s[j].Name
+}
+
+// ListWorkspacesOptions are the query parameters accepted by
+// Client.ListWorkspaces.
+type ListWorkspacesOptions struct {
+	PageNumber int
+	PageSize   int
+
+	// Search filters the results to workspaces whose name contains this
+	// substring.
+	Search string
+}
+
+func (o *ListWorkspacesOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.PageNumber != 0 {
+		q.Set("page[number]", strconv.Itoa(o.PageNumber))
+	}
+	if o.PageSize != 0 {
+		q.Set("page[size]", strconv.Itoa(o.PageSize))
+	}
+	if o.Search != "" {
+		q.Set("search[name]", o.Search)
+	}
+	return q
+}
+
+// WorkspaceList is a single page of workspaces, as returned by
+// Client.ListWorkspaces.
+type WorkspaceList struct {
+	Items      []*Workspace
+	Pagination *Pagination
+}
+
+// ListWorkspaces returns a single page of the workspaces within an
+// organization, according to opts. Pass opts.PageNumber to page through
+// the full set; see Workspaces for a helper that does this automatically.
+func (c *Client) ListWorkspaces(orgName string, opts *ListWorkspacesOptions) (*WorkspaceList, error) {
+	if orgName == "" {
+		return nil, errors.New("Organization is required")
+	}
+
+	path := "/organizations/" + orgName + "/workspaces"
+	raw, pagination, err := c.doPaginated("GET", path, opts.query(), reflect.TypeOf(new(Workspace)))
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*Workspace, len(raw))
+	for i, r := range raw {
+		workspaces[i] = r.(*Workspace)
+	}
+
+	return &WorkspaceList{Items: workspaces, Pagination: pagination}, nil
+}
+
+// Workspaces returns all of the workspaces within an organization, paging
+// through the full result set on the caller's behalf.
+func (c *Client) Workspaces(orgName string) ([]*Workspace, error) {
+	var workspaces []*Workspace
+
+	opts := &ListWorkspacesOptions{}
+	for {
+		list, err := c.ListWorkspaces(orgName, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaces = append(workspaces, list.Items...)
+
+		if list.Pagination.NextPage == 0 {
+			return workspaces, nil
+		}
+		opts.PageNumber = list.Pagination.NextPage
+	}
+}
+
+// Workspace retrieves a single workspace by organization and name.
+func (c *Client) Workspace(orgName, name string) (*Workspace, error) {
+	if orgName == "" {
+		return nil, errors.New("Organization is required")
+	}
+	if name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	ws := &Workspace{}
+	path := "/organizations/" + orgName + "/workspaces/" + name
+	if err := c.do("GET", path, nil, nil, ws); err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// CreateWorkspaceInput is used as input to Client.CreateWorkspace.
+type CreateWorkspaceInput struct {
+	Organization     *string
+	Name             *string
+	AutoApply        *bool
+	TerraformVersion *string
+	WorkingDirectory *string
+
+	VCSRepo             *VCSRepoOptions
+	FileTriggersEnabled *bool
+	TriggerPrefixes     []string
+}
+
+func (i *CreateWorkspaceInput) valid() error {
+	if !validString(i.Organization) {
+		return errors.New("Organization is required")
+	}
+	if !validString(i.Name) {
+		return errors.New("Name is required")
+	}
+	return nil
+}
+
+// CreateWorkspaceOutput is returned by Client.CreateWorkspace.
+type CreateWorkspaceOutput struct {
+	Workspace *Workspace
+}
+
+// CreateWorkspace creates a new workspace within an organization.
+func (c *Client) CreateWorkspace(input *CreateWorkspaceInput) (*CreateWorkspaceOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &Workspace{
+		Name:                input.Name,
+		AutoApply:           input.AutoApply,
+		TerraformVersion:    input.TerraformVersion,
+		WorkingDirectory:    input.WorkingDirectory,
+		VCSRepo:             input.VCSRepo.toVCSRepo(),
+		FileTriggersEnabled: input.FileTriggersEnabled,
+		TriggerPrefixes:     input.TriggerPrefixes,
+	}
+
+	ws := &Workspace{}
+	path := "/organizations/" + *input.Organization + "/workspaces"
+	if err := c.do("POST", path, nil, body, ws); err != nil {
+		return nil, err
+	}
+
+	return &CreateWorkspaceOutput{Workspace: ws}, nil
+}
+
+// ModifyWorkspaceInput is used as input to Client.ModifyWorkspace.
+type ModifyWorkspaceInput struct {
+	Organization     *string
+	Name             *string
+	Rename           *string
+	AutoApply        *bool
+	TerraformVersion *string
+	WorkingDirectory *string
+
+	// VCSRepo updates the workspace's VCS connection. Leave nil to leave
+	// it unchanged; pass &VCSRepoOptions{} to clear it.
+	VCSRepo             *VCSRepoOptions
+	FileTriggersEnabled *bool
+	TriggerPrefixes     []string
+}
+
+func (i *ModifyWorkspaceInput) valid() error {
+	if !validString(i.Organization) {
+		return errors.New("Organization is required")
+	}
+	if !validString(i.Name) {
+		return errors.New("Name is required")
+	}
+	return nil
+}
+
+// ModifyWorkspaceOutput is returned by Client.ModifyWorkspace.
+type ModifyWorkspaceOutput struct {
+	Workspace *Workspace
+}
+
+// ModifyWorkspace updates the attributes of an existing workspace.
+func (c *Client) ModifyWorkspace(input *ModifyWorkspaceInput) (*ModifyWorkspaceOutput, error) {
+	if err := input.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &Workspace{
+		Name:                input.Rename,
+		AutoApply:           input.AutoApply,
+		TerraformVersion:    input.TerraformVersion,
+		WorkingDirectory:    input.WorkingDirectory,
+		VCSRepo:             input.VCSRepo.toVCSRepo(),
+		FileTriggersEnabled: input.FileTriggersEnabled,
+		TriggerPrefixes:     input.TriggerPrefixes,
+	}
+
+	ws := &Workspace{}
+	path := "/organizations/" + *input.Organization + "/workspaces/" + *input.Name
+	if err := c.do("PATCH", path, nil, body, ws); err != nil {
+		return nil, err
+	}
+
+	return &ModifyWorkspaceOutput{Workspace: ws}, nil
+}
+
+// DeleteWorkspaceInput is used as input to Client.DeleteWorkspace.
+type DeleteWorkspaceInput struct {
+	Organization *string
+	Name         *string
+}
+
+// DeleteWorkspaceOutput is returned by Client.DeleteWorkspace.
+type DeleteWorkspaceOutput struct{}
+
+// DeleteWorkspace deletes a workspace from an organization.
+func (c *Client) DeleteWorkspace(input *DeleteWorkspaceInput) (*DeleteWorkspaceOutput, error) {
+	if !validString(input.Organization) {
+		return nil, errors.New("Organization is required")
+	}
+	if !validString(input.Name) {
+		return nil, errors.New("Name is required")
+	}
+
+	path := "/organizations/" + *input.Organization + "/workspaces/" + *input.Name
+	if err := c.do("DELETE", path, nil, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return &DeleteWorkspaceOutput{}, nil
+}
@@ -0,0 +1,76 @@
+package tfe
+
+import "errors"
+
+// LockWorkspaceInput is used as input to Client.LockWorkspace.
+type LockWorkspaceInput struct {
+	// Reason is recorded as the operator's reason for locking the
+	// workspace.
+	Reason *string
+}
+
+type lockWorkspaceBody struct {
+	ID     *string `jsonapi:"primary,workspaces"`
+	Reason *string `jsonapi:"attr,reason"`
+}
+
+// LockWorkspace locks a workspace, preventing other runs from starting
+// against it until it is unlocked. If the workspace is already locked,
+// ErrWorkspaceLocked is returned.
+func (c *Client) LockWorkspace(workspaceID string, input *LockWorkspaceInput) (*Workspace, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+	if input == nil {
+		input = &LockWorkspaceInput{}
+	}
+
+	locked := &Workspace{}
+	path := "/workspaces/" + workspaceID + "/actions/lock"
+	if err := c.do("POST", path, nil, &lockWorkspaceBody{Reason: input.Reason}, locked); err != nil {
+		if err == errConflict {
+			return nil, ErrWorkspaceLocked
+		}
+		return nil, err
+	}
+
+	return locked, nil
+}
+
+// UnlockWorkspace unlocks a workspace that was previously locked with
+// LockWorkspace. If the workspace is not locked, ErrWorkspaceNotLocked is
+// returned.
+func (c *Client) UnlockWorkspace(workspaceID string) (*Workspace, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+
+	unlocked := &Workspace{}
+	path := "/workspaces/" + workspaceID + "/actions/unlock"
+	if err := c.do("POST", path, nil, nil, unlocked); err != nil {
+		if err == errConflict {
+			return nil, ErrWorkspaceNotLocked
+		}
+		return nil, err
+	}
+
+	return unlocked, nil
+}
+
+// ForceUnlockWorkspace unlocks a workspace regardless of who (or what run)
+// holds the lock. It is intended for administrators recovering from a
+// stuck run, and unlike UnlockWorkspace does not require the caller to
+// already know the workspace is locked.
+func (c *Client) ForceUnlockWorkspace(workspaceID string) (*Workspace, error) {
+	if workspaceID == "" {
+		return nil, errors.New("WorkspaceID is required")
+	}
+
+	unlocked := &Workspace{}
+	path := "/workspaces/" + workspaceID + "/actions/force-unlock"
+	if err := c.do("POST", path, nil, nil, unlocked); err != nil {
+		return nil, err
+	}
+
+	return unlocked, nil
+}
@@ -0,0 +1,75 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockWorkspace(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("when the workspace is unlocked", func(t *testing.T) {
+		locked, err := client.LockWorkspace(*ws.ID, &LockWorkspaceInput{Reason: String("testing")})
+		require.Nil(t, err)
+		assert.True(t, *locked.Locked)
+	})
+
+	t.Run("when the workspace is already locked", func(t *testing.T) {
+		_, err := client.LockWorkspace(*ws.ID, &LockWorkspaceInput{Reason: String("testing")})
+		assert.Equal(t, ErrWorkspaceLocked, err)
+	})
+}
+
+func TestUnlockWorkspace(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	t.Run("when the workspace is not locked", func(t *testing.T) {
+		_, err := client.UnlockWorkspace(*ws.ID)
+		assert.Equal(t, ErrWorkspaceNotLocked, err)
+	})
+
+	t.Run("when the workspace is locked", func(t *testing.T) {
+		_, err := client.LockWorkspace(*ws.ID, nil)
+		require.Nil(t, err)
+
+		unlocked, err := client.UnlockWorkspace(*ws.ID)
+		require.Nil(t, err)
+		assert.False(t, *unlocked.Locked)
+	})
+}
+
+func TestForceUnlockWorkspace(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	ws, wsCleanup := createWorkspace(t, client, org)
+	defer wsCleanup()
+
+	_, err := client.LockWorkspace(*ws.ID, nil)
+	require.Nil(t, err)
+
+	unlocked, err := client.ForceUnlockWorkspace(*ws.ID)
+	require.Nil(t, err)
+	assert.False(t, *unlocked.Locked)
+
+	t.Run("when workspace ID is missing", func(t *testing.T) {
+		_, err := client.ForceUnlockWorkspace("")
+		assert.EqualError(t, err, "WorkspaceID is required")
+	})
+}
@@ -32,6 +32,53 @@ func TestWorkspaces(t *testing.T) {
 	assert.Equal(t, expect, workspaces)
 }
 
+func TestListWorkspaces(t *testing.T) {
+	client := testClient(t)
+
+	org, orgCleanup := createOrganization(t, client)
+	defer orgCleanup()
+
+	const total = 3
+	for i := 0; i < total; i++ {
+		_, cleanup := createWorkspace(t, client, org)
+		defer cleanup()
+	}
+
+	t.Run("with an explicit page size", func(t *testing.T) {
+		list, err := client.ListWorkspaces(*org.Name, &ListWorkspacesOptions{PageSize: 2})
+		require.Nil(t, err)
+
+		assert.Len(t, list.Items, 2)
+		assert.Equal(t, 1, list.Pagination.CurrentPage)
+		assert.Equal(t, 2, list.Pagination.NextPage)
+		assert.Equal(t, total, list.Pagination.TotalCount)
+	})
+
+	t.Run("iterating through every page", func(t *testing.T) {
+		workspaces, err := client.Workspaces(*org.Name)
+		require.Nil(t, err)
+
+		assert.Len(t, workspaces, total)
+	})
+
+	t.Run("with a search filter", func(t *testing.T) {
+		ws, wsCleanup := createWorkspace(t, client, org)
+		defer wsCleanup()
+
+		list, err := client.ListWorkspaces(*org.Name, &ListWorkspacesOptions{Search: *ws.Name})
+		require.Nil(t, err)
+
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, ws.Name, list.Items[0].Name)
+	})
+
+	t.Run("when organization is missing", func(t *testing.T) {
+		list, err := client.ListWorkspaces("", nil)
+		assert.EqualError(t, err, "Organization is required")
+		assert.Nil(t, list)
+	})
+}
+
 func TestWorkspace(t *testing.T) {
 	client := testClient(t)
 
@@ -100,6 +147,24 @@ func TestCreateWorkspace(t *testing.T) {
 		}
 	})
 
+	t.Run("with a VCS repo", func(t *testing.T) {
+		input := &CreateWorkspaceInput{
+			Organization: org.Name,
+			Name:         String("vcs-workspace"),
+			VCSRepo: &VCSRepoOptions{
+				Identifier: String("my-org/my-repo"),
+				Branch:     String("main"),
+			},
+		}
+
+		output, err := client.CreateWorkspace(input)
+		require.Nil(t, err)
+
+		require.NotNil(t, output.Workspace.VCSRepo)
+		assert.Equal(t, input.VCSRepo.Identifier, output.Workspace.VCSRepo.Identifier)
+		assert.Equal(t, input.VCSRepo.Branch, output.Workspace.VCSRepo.Branch)
+	})
+
 	t.Run("when input is missing organization", func(t *testing.T) {
 		result, err := client.CreateWorkspace(&CreateWorkspaceInput{
 			Name: String("foo"),
@@ -183,6 +248,70 @@ func TestModifyWorkspace(t *testing.T) {
 		}
 	})
 
+	// The subtest above renamed ws, so the VCS subtests below work on a
+	// workspace of their own.
+	vcsWs, vcsWsCleanup := createWorkspace(t, client, org)
+	defer vcsWsCleanup()
+
+	t.Run("set-VCS", func(t *testing.T) {
+		output, err := client.ModifyWorkspace(&ModifyWorkspaceInput{
+			Organization: org.Name,
+			Name:         vcsWs.Name,
+			VCSRepo: &VCSRepoOptions{
+				Identifier: String("my-org/my-repo"),
+				Branch:     String("main"),
+			},
+		})
+		require.Nil(t, err)
+
+		require.NotNil(t, output.Workspace.VCSRepo)
+		assert.Equal(t, String("my-org/my-repo"), output.Workspace.VCSRepo.Identifier)
+		assert.Equal(t, String("main"), output.Workspace.VCSRepo.Branch)
+	})
+
+	t.Run("change-branch", func(t *testing.T) {
+		output, err := client.ModifyWorkspace(&ModifyWorkspaceInput{
+			Organization: org.Name,
+			Name:         vcsWs.Name,
+			VCSRepo: &VCSRepoOptions{
+				Identifier: String("my-org/my-repo"),
+				Branch:     String("develop"),
+			},
+		})
+		require.Nil(t, err)
+
+		require.NotNil(t, output.Workspace.VCSRepo)
+		assert.Equal(t, String("develop"), output.Workspace.VCSRepo.Branch)
+	})
+
+	t.Run("modifying an unrelated field leaves VCSRepo untouched", func(t *testing.T) {
+		before, err := client.Workspace(*org.Name, *vcsWs.Name)
+		require.Nil(t, err)
+		require.NotNil(t, before.VCSRepo)
+
+		output, err := client.ModifyWorkspace(&ModifyWorkspaceInput{
+			Organization: org.Name,
+			Name:         vcsWs.Name,
+			AutoApply:    Bool(true),
+		})
+		require.Nil(t, err)
+
+		require.NotNil(t, output.Workspace.VCSRepo)
+		assert.Equal(t, before.VCSRepo.Identifier, output.Workspace.VCSRepo.Identifier)
+		assert.Equal(t, before.VCSRepo.Branch, output.Workspace.VCSRepo.Branch)
+	})
+
+	t.Run("clear-VCS", func(t *testing.T) {
+		output, err := client.ModifyWorkspace(&ModifyWorkspaceInput{
+			Organization: org.Name,
+			Name:         vcsWs.Name,
+			VCSRepo:      &VCSRepoOptions{},
+		})
+		require.Nil(t, err)
+
+		assert.Nil(t, output.Workspace.VCSRepo)
+	})
+
 	t.Run("when input is missing organization", func(t *testing.T) {
 		result, err := client.ModifyWorkspace(&ModifyWorkspaceInput{
 			Name: String("foo"),